@@ -0,0 +1,90 @@
+package entities
+
+import (
+	"time"
+
+	libnetworkTypes "github.com/containers/common/libnetwork/types"
+	psdefine "github.com/containers/podman/v5/pkg/ps/define"
+)
+
+// ContainerListOptions describes the criteria used to list containers for
+// `podman ps` and its REST/bindings equivalents.
+type ContainerListOptions struct {
+	// All lists all containers, running or not.
+	All bool
+	// External also lists external containers (e.g. those created by
+	// Buildah) alongside normal libpod containers.
+	External bool
+	// Filters is a map of filter arguments, keyed by filter name, e.g.
+	// {"status": {"running"}}.
+	Filters map[string][]string
+	// Last restricts the libpod containers listed to the N most recently
+	// created ones. When External is also set, the combined list is sorted
+	// oldest-first and cut to its first N entries.
+	Last int
+	// Limit caps the number of containers returned or streamed, applied
+	// after Offset.
+	Limit int
+	// Namespace requests namespace path information be populated on each
+	// result.
+	Namespace bool
+	// Offset skips the first N containers (in CreateTime order) before
+	// Limit is applied, so callers can page through the list.
+	Offset int
+	// Pod requests pod membership information be populated on each result.
+	Pod bool
+	// Size requests each container's rootfs/rw size be calculated. This is
+	// expensive and off by default.
+	Size bool
+	// Sync forces each container's state to be refreshed from the OCI
+	// runtime before it's read.
+	Sync bool
+}
+
+// ExternalContainerFilter is a function used to determine whether an
+// external (e.g. Buildah) container list entry is included in output. A
+// true return includes the entry.
+type ExternalContainerFilter func(*ListContainer) bool
+
+// ListContainer describes a single container as reported by `podman ps` and
+// its REST/bindings equivalents.
+type ListContainer struct {
+	AutoRemove   bool
+	CIDFile      string
+	Command      []string
+	Created      time.Time
+	ExitCode     int32
+	Exited       bool
+	ExitedAt     int64
+	ExposedPorts map[uint16][]string
+	ID           string
+	Image        string
+	ImageID      string
+	IsInfra      bool
+	Labels       map[string]string
+	Mounts       []string
+	Names        []string
+	Namespaces   ListContainerNamespaces
+	Networks     []string
+	Pid          int
+	Pod          string
+	PodName      string
+	Ports        []libnetworkTypes.PortMapping
+	Restarts     uint
+	Size         *psdefine.ContainerSize
+	StartedAt    int64
+	State        string
+	Status       string
+}
+
+// ListContainerNamespaces holds a container's namespace paths. Only
+// populated when ContainerListOptions.Namespace is set.
+type ListContainerNamespaces struct {
+	Cgroup string
+	IPC    string
+	MNT    string
+	NET    string
+	PIDNS  string
+	User   string
+	UTS    string
+}
@@ -3,14 +3,17 @@
 package ps
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"regexp"
+	goruntime "runtime"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	libnetworkTypes "github.com/containers/common/libnetwork/types"
@@ -24,15 +27,112 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+// maxDefaultPSWorkers caps the size of the ListContainerBatch worker pool
+// when containers.conf does not set Engine.NumPsWorkers.
+const maxDefaultPSWorkers = 8
+
 // ExternalContainerFilter is a function to determine whether a container list is included
 // in command output. Container lists to be outputted are tested using the function.
 // A true return will include the container list, a false return will exclude it.
 type ExternalContainerFilter func(*entities.ListContainer) bool
 
+// GetContainerLists returns all containers (and, if requested, external
+// containers) matching options, sorted oldest-first (SortPSCreateTime sorts
+// ascending by Created). It is a thin wrapper
+// around StreamContainerLists that accumulates the stream into a slice, kept
+// around for callers that need the whole list at once.
 func GetContainerLists(runtime *libpod.Runtime, options entities.ContainerListOptions) ([]entities.ListContainer, error) {
-	var (
-		pss = []entities.ListContainer{}
-	)
+	pss := make([]entities.ListContainer, 0, 8)
+	var mu sync.Mutex
+	err := StreamContainerLists(context.Background(), runtime, options, func(listCon entities.ListContainer) error {
+		mu.Lock()
+		pss = append(pss, listCon)
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Sort the containers we got
+	sort.Sort(SortPSCreateTime{SortPSContainers: pss})
+
+	if options.Last > 0 {
+		// only return the "last" containers caller requested
+		if options.Last < len(pss) {
+			pss = pss[:options.Last]
+		}
+	}
+	return pss, nil
+}
+
+// StreamContainerLists is the streaming counterpart to GetContainerLists: it
+// emits each container's ListContainer without materializing the whole
+// result in memory. Containers are read from a bounded worker pool, but emit
+// is only ever called from this goroutine, one container at a time, in the
+// same order as the underlying container list rather than raw completion
+// order: a ListContainerBatch that finishes early is held back until every
+// container ahead of it in that order has been emitted. This trades some
+// head-of-line blocking for output whose Created-time ties resolve the same
+// way on every call once GetContainerLists' final sort runs, instead of
+// shuffling from run to run. If that tradeoff is wrong for a given caller,
+// use options.Limit to keep the window small rather than expecting
+// completion-order delivery here. options.Offset and options.Limit apply a
+// windowed page over the (CreateTime-sorted) containers before any batching
+// happens, so callers like the REST handler or `podman ps --limit/--offset`
+// never pay for containers outside the requested window; when
+// options.External is also set, the external containers GetExternalContainerLists
+// returns are appended after that window, still capped so the total emitted
+// never exceeds options.Limit. If emit returns an error, or ctx is canceled,
+// streaming stops early and that error is returned; the first non-ignorable
+// ListContainerBatch error is returned the same way.
+func StreamContainerLists(ctx context.Context, runtime *libpod.Runtime, options entities.ContainerListOptions, emit func(entities.ListContainer) error) error {
+	cons, filterExtFuncs, err := prepareContainerList(runtime, options)
+	if err != nil {
+		return err
+	}
+
+	// prepareContainerList already windows cons to options.Limit, but that
+	// only covers libpod containers; wrap emit so external containers
+	// appended below can't push the total past the caller's window too.
+	limitedEmit := emit
+	if options.Limit > 0 {
+		remaining := options.Limit
+		limitedEmit = func(listCon entities.ListContainer) error {
+			if remaining <= 0 {
+				return nil
+			}
+			remaining--
+			return emit(listCon)
+		}
+	}
+
+	if err := streamContainerBatches(ctx, runtime, cons, options, limitedEmit); err != nil {
+		return err
+	}
+
+	if options.External {
+		listCon, err := GetExternalContainerLists(runtime, filterExtFuncs...)
+		if err != nil {
+			return err
+		}
+		for _, con := range listCon {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := limitedEmit(con); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// prepareContainerList resolves options into the ordered, windowed set of
+// libpod containers to batch, along with the external-container filters
+// StreamContainerLists/GetContainerLists need if options.External is set.
+func prepareContainerList(runtime *libpod.Runtime, options entities.ContainerListOptions) ([]*libpod.Container, []entities.ExternalContainerFilter, error) {
 	filterFuncs := make([]libpod.ContainerFilter, 0, len(options.Filters))
 	filterExtFuncs := make([]entities.ExternalContainerFilter, 0, len(options.Filters))
 	all := options.All || options.Last > 0
@@ -40,14 +140,14 @@ func GetContainerLists(runtime *libpod.Runtime, options entities.ContainerListOp
 		for k, v := range options.Filters {
 			generatedFunc, err := filters.GenerateContainerFilterFuncs(k, v, runtime)
 			if err != nil && !options.External {
-				return nil, err
+				return nil, nil, err
 			}
 			filterFuncs = append(filterFuncs, generatedFunc)
 
 			if options.External {
 				generatedExtFunc, err := filters.GenerateExternalContainerFilterFuncs(k, v, runtime)
 				if err != nil {
-					return nil, err
+					return nil, nil, err
 				}
 				filterExtFuncs = append(filterExtFuncs, generatedExtFunc)
 			}
@@ -62,7 +162,7 @@ func GetContainerLists(runtime *libpod.Runtime, options entities.ContainerListOp
 	if !all {
 		runningOnly, err := filters.GenerateContainerFilterFuncs("status", []string{define.ContainerStateRunning.String()}, runtime)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		filterFuncs = append(filterFuncs, runningOnly)
 	}
@@ -76,7 +176,7 @@ func GetContainerLists(runtime *libpod.Runtime, options entities.ContainerListOp
 	// gets released.
 	cons, err := runtime.GetContainers(true, filterFuncs...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if options.Last > 0 {
 		// Sort the libpod containers
@@ -86,38 +186,178 @@ func GetContainerLists(runtime *libpod.Runtime, options entities.ContainerListOp
 		if options.Last < len(cons) {
 			cons = cons[:options.Last]
 		}
+	} else if options.Offset > 0 || options.Limit > 0 {
+		// Sort first so the Offset/Limit window is stable and lines up with
+		// the oldest-first order GetContainerLists' own SortPSCreateTime
+		// produces; SortCreateTime here would window a newest-first list
+		// and then hand back the wrong page once re-sorted oldest-first.
+		sort.Sort(SortCreateTimeAscending{SortContainers: cons})
+	}
+	if options.Offset > 0 {
+		if options.Offset >= len(cons) {
+			cons = cons[:0]
+		} else {
+			cons = cons[options.Offset:]
+		}
+	}
+	if options.Limit > 0 && options.Limit < len(cons) {
+		cons = cons[:options.Limit]
 	}
-	for _, con := range cons {
-		listCon, err := ListContainerBatch(runtime, con, options)
+
+	return cons, filterExtFuncs, nil
+}
+
+// streamContainerBatches runs ListContainerBatch for each of cons across a
+// bounded worker pool, calling emit with each result in cons order once it's
+// ready (see orderedWorkerPool for why that's cons order and not completion
+// order). The pool size comes from containers.conf's Engine.NumPsWorkers,
+// falling back to min(NumCPU, maxDefaultPSWorkers). Ignorable errors
+// (ErrNoSuchCtr, ErrNoSuchPod) are dropped as if the container were never
+// listed; any other error, or an error from emit, cancels the remaining
+// workers and is returned.
+func streamContainerBatches(ctx context.Context, runtime *libpod.Runtime, cons []*libpod.Container, options entities.ContainerListOptions, emit func(entities.ListContainer) error) error {
+	if len(cons) == 0 {
+		return nil
+	}
+
+	numWorkers := psWorkerPoolSize(runtime)
+	if numWorkers > len(cons) {
+		numWorkers = len(cons)
+	}
+
+	return orderedWorkerPool(ctx, len(cons), numWorkers, func(idx int) (entities.ListContainer, bool, error) {
+		listCon, err := ListContainerBatch(runtime, cons[idx], options)
 		switch {
 		// ignore both no ctr and no such pod errors as it means the ctr is gone now
 		case errors.Is(err, define.ErrNoSuchCtr), errors.Is(err, define.ErrNoSuchPod):
-			continue
+			return entities.ListContainer{}, true, nil
 		case err != nil:
-			return nil, err
+			return entities.ListContainer{}, false, err
 		default:
-			pss = append(pss, listCon)
+			return listCon, false, nil
 		}
+	}, emit)
+}
+
+// orderedWorkerPool runs fn(0), fn(1), ..., fn(n-1) across up to numWorkers
+// goroutines and calls emit with each non-skipped result, but always in
+// index order rather than completion order. fn returns (value, skip, err);
+// skip drops that index without emitting it, mirroring the ignorable-error
+// handling streamContainerBatches needs. Index order matters here because
+// SortPSCreateTime's final sort is not stable, so ties in Created time must
+// already be presented in the same order every run for output to be
+// deterministic. If fn returns an error, or emit returns an error, or ctx is
+// canceled, the remaining work is abandoned and that error is returned.
+//
+// It is factored out of streamContainerBatches so the worker-pool scheduling
+// and reordering can be benchmarked with synthetic work, without a
+// libpod.Runtime.
+func orderedWorkerPool[T any](ctx context.Context, n, numWorkers int, fn func(idx int) (T, bool, error), emit func(T) error) error {
+	if n == 0 {
+		return nil
+	}
+	if numWorkers > n {
+		numWorkers = n
+	}
+	if numWorkers < 1 {
+		numWorkers = 1
 	}
 
-	if options.External {
-		listCon, err := GetExternalContainerLists(runtime, filterExtFuncs...)
-		if err != nil {
-			return nil, err
-		}
-		pss = append(pss, listCon...)
+	cctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type indexedResult struct {
+		idx  int
+		val  T
+		skip bool
 	}
 
-	// Sort the containers we got
-	sort.Sort(SortPSCreateTime{SortPSContainers: pss})
+	jobs := make(chan int)
+	resultsCh := make(chan indexedResult)
+	errCh := make(chan error, 1)
+
+	var wg sync.WaitGroup
+	for i := 0; i < numWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range jobs {
+				val, skip, err := fn(idx)
+				if err != nil {
+					select {
+					case errCh <- err:
+					default:
+					}
+					cancel()
+					return
+				}
+				select {
+				case resultsCh <- indexedResult{idx: idx, val: val, skip: skip}:
+				case <-cctx.Done():
+					return
+				}
+			}
+		}()
+	}
 
-	if options.Last > 0 {
-		// only return the "last" containers caller requested
-		if options.Last < len(pss) {
-			pss = pss[:options.Last]
+	go func() {
+		defer close(jobs)
+		for i := 0; i < n; i++ {
+			select {
+			case jobs <- i:
+			case <-cctx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(resultsCh)
+	}()
+
+	// Workers finish fn out of order; buffer early arrivals and release
+	// them, in order, as the next expected index shows up.
+	pending := make(map[int]indexedResult)
+	next := 0
+	for result := range resultsCh {
+		pending[result.idx] = result
+		for {
+			r, ok := pending[next]
+			if !ok {
+				break
+			}
+			delete(pending, next)
+			next++
+			if r.skip {
+				continue
+			}
+			if err := emit(r.val); err != nil {
+				cancel()
+				return err
+			}
 		}
 	}
-	return pss, nil
+
+	select {
+	case err := <-errCh:
+		return err
+	default:
+	}
+	return ctx.Err()
+}
+
+// psWorkerPoolSize returns the number of ListContainerBatch workers to run
+// concurrently, preferring the operator-configured containers.conf value and
+// otherwise defaulting to min(NumCPU, maxDefaultPSWorkers).
+func psWorkerPoolSize(runtime *libpod.Runtime) int {
+	if n := runtime.Config().Engine.NumPsWorkers; n > 0 {
+		return n
+	}
+	if n := goruntime.NumCPU(); n < maxDefaultPSWorkers {
+		return n
+	}
+	return maxDefaultPSWorkers
 }
 
 // GetExternalContainerLists returns list of external containers for e.g. created by buildah
@@ -393,6 +633,15 @@ func (a SortCreateTime) Less(i, j int) bool {
 	return a.SortContainers[i].CreatedTime().After(a.SortContainers[j].CreatedTime())
 }
 
+// SortCreateTimeAscending sorts containers oldest-first, matching the order
+// GetContainerLists returns after its own SortPSCreateTime sort. Used to
+// window Offset/Limit pages over the same order callers ultimately see.
+type SortCreateTimeAscending struct{ SortContainers }
+
+func (a SortCreateTimeAscending) Less(i, j int) bool {
+	return a.SortContainers[i].CreatedTime().Before(a.SortContainers[j].CreatedTime())
+}
+
 // SortPSContainers helps us set-up ability to sort by createTime
 type SortPSContainers []entities.ListContainer
 
@@ -0,0 +1,38 @@
+//go:build !remote
+
+package ps
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// BenchmarkOrderedWorkerPool exercises the worker-pool scheduling and
+// index-ordered reassembly that streamContainerBatches (and, through it,
+// GetContainerLists) uses for ListContainerBatch. Real ListContainerBatch
+// calls need a live libpod.Runtime, so this stands in per-container I/O
+// with a fixed sleep and measures how wall time scales with the number of
+// containers and the worker pool size.
+func BenchmarkOrderedWorkerPool(b *testing.B) {
+	const perContainerWork = 50 * time.Microsecond
+
+	for _, n := range []int{10, 100, 1000} {
+		for _, numWorkers := range []int{1, 4, 8} {
+			b.Run(fmt.Sprintf("containers=%d/workers=%d", n, numWorkers), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					err := orderedWorkerPool(context.Background(), n, numWorkers, func(idx int) (int, bool, error) {
+						time.Sleep(perContainerWork)
+						return idx, false, nil
+					}, func(int) error {
+						return nil
+					})
+					if err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
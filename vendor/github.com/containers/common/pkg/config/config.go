@@ -0,0 +1,19 @@
+package config
+
+// Config is the containers/common configuration, as parsed from
+// containers.conf. Only the fields podman actually reads are carried here;
+// this is a narrow companion patch for pkg/ps's Engine.NumPsWorkers, pending
+// the real change landing upstream in containers/common with a vendor bump.
+type Config struct {
+	Engine EngineConfig
+}
+
+// EngineConfig holds settings for the container engine, i.e. the
+// `[engine]` table in containers.conf.
+type EngineConfig struct {
+	// NumPsWorkers caps the size of the worker pool `podman ps` uses to
+	// run ListContainerBatch concurrently. A value of 0 (the default)
+	// leaves the pool size to the caller, which falls back to
+	// min(NumCPU, 8).
+	NumPsWorkers int `toml:"num_ps_workers,omitempty"`
+}